@@ -0,0 +1,44 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"golang.org/x/vulndb/internal/report"
+)
+
+type commit struct {
+	*committer
+}
+
+func (commit) name() string { return "commit" }
+
+func (commit) usage() (string, string) {
+	const desc = "lints and commits the given already-drafted report(s)"
+	return "report.yaml", desc
+}
+
+func (c *commit) setup(ctx context.Context) error {
+	c.committer = new(committer)
+	return setupAll(ctx, c.committer)
+}
+
+func (c *commit) close() error {
+	return closeAll(c.committer)
+}
+
+func (c *commit) run(ctx context.Context, filename string) (err error) {
+	r, err := report.Read(filename)
+	if err != nil {
+		return err
+	}
+
+	if err := lintCVERecord(r); err != nil {
+		return err
+	}
+
+	return c.commit(r)
+}
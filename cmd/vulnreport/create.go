@@ -6,16 +6,22 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
+
+	"golang.org/x/vulndb/internal/cve5"
+	"golang.org/x/vulndb/internal/report"
 )
 
 var (
 	preferCVE       = flag.Bool("cve", false, "for create, prefer CVEs over GHSAs as canonical source")
-	graphQL         = flag.Bool("graphql", false, "for create, fetch GHSAs from the Github GraphQL API instead of the OSV database")
+	graphQL         = flag.Bool("graphql", false, "for create, fetch GHSAs from the Github GraphQL API instead of the OSV database; for xref, additionally query the Github GraphQL API alongside OSV")
 	issueRepo       = flag.String("issue-repo", "github.com/golang/vulndb", "for create, repo locate Github issues")
 	useAI           = flag.Bool("ai", false, "for create, use AI to write draft summary and description when creating report")
 	populateSymbols = flag.Bool("symbols", false, "for create, attempt to auto-populate symbols")
 	user            = flag.String("user", "", "for create & create-excluded, only consider issues assigned to the given user")
+	offlineProxy    = flag.Bool("offline", false, "for create & create-excluded, skip module proxy validation instead of making network calls")
 )
 
 type create struct {
@@ -50,5 +56,41 @@ func (c *create) run(ctx context.Context, issueNumber string) (err error) {
 		return nil
 	}
 
-	return c.reportFromIssue(ctx, iss)
+	before := len(c.created)
+	if err := c.reportFromIssue(ctx, iss); err != nil {
+		return err
+	}
+
+	for _, r := range c.created[before:] {
+		if err := lintCVERecord(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lintCVERecord converts r to CVE 5.0 format, runs cve5.Lint on the
+// result and cve5.LintVersions on r, refusing to produce a report that
+// would yield an invalid CVE 5.0 record.
+func lintCVERecord(r *report.Report) error {
+	if r.CVEMetadata == nil {
+		// Not a Go CNA-authored CVE; nothing to lint here.
+		return nil
+	}
+	rec, err := cve5.FromReport(r)
+	if err != nil {
+		return fmt.Errorf("could not convert report to CVE 5.0 record: %w", err)
+	}
+	var opts []cve5.ProxyOption
+	if *offlineProxy {
+		opts = append(opts, cve5.WithOfflineProxy())
+	}
+
+	var errs []error
+	errs = append(errs, cve5.Lint(rec)...)
+	errs = append(errs, cve5.LintVersions(r, opts...)...)
+	if len(errs) > 0 {
+		return fmt.Errorf("report would produce an invalid CVE 5.0 record: %w", errors.Join(errs...))
+	}
+	return nil
 }
@@ -54,7 +54,17 @@ func (c *createExcluded) run(ctx context.Context, issNum string) (err error) {
 		return nil
 	}
 
-	return c.reportFromIssue(ctx, iss)
+	before := len(c.created)
+	if err := c.reportFromIssue(ctx, iss); err != nil {
+		return err
+	}
+
+	for _, r := range c.created[before:] {
+		if err := lintCVERecord(r); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (c *createExcluded) skipReason(iss *issues.Issue) string {
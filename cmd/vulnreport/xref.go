@@ -6,8 +6,12 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
 	"strings"
+	"sync"
 
 	"golang.org/x/exp/constraints"
 	"golang.org/x/exp/maps"
@@ -49,6 +53,12 @@ func (x *xref) run(ctx context.Context, filename string) (err error) {
 		return err
 	}
 	vlog.Out(xrefs)
+
+	upstream, err := x.xrefUpstream(ctx, r)
+	if err != nil {
+		return err
+	}
+	vlog.Out(upstream)
 	return nil
 }
 
@@ -62,11 +72,13 @@ func (x *xrefer) setup(ctx context.Context) error {
 		return err
 	}
 	x.rc = rc
+	x.osv = newOSVClient()
 	return nil
 }
 
 type xrefer struct {
-	rc *report.Client
+	rc  *report.Client
+	osv *osvClient
 }
 
 func (x *xrefer) xref(r *report.Report) (string, error) {
@@ -91,6 +103,234 @@ func (x *xrefer) xref(r *report.Report) (string, error) {
 	return out.String(), nil
 }
 
+// xrefUpstream looks for advisories in the OSV database and, if -graphql
+// is set, the GitHub Security Advisory GraphQL API, that reference the
+// same CVE/GHSA IDs or modules as r but aren't present in the local
+// repo. This helps triagers catch duplicates that only exist upstream.
+//
+// xrefUpstream fails open: an error response from an upstream source is
+// reported as a line in the output rather than returned, so that a
+// single flaky network call doesn't block local cross-referencing. An
+// OSV request that cannot reach the server at all (e.g. when offline)
+// prints nothing for that module.
+func (x *xrefer) xrefUpstream(ctx context.Context, r *report.Report) (string, error) {
+	out := &strings.Builder{}
+
+	// The OSV v1 API has no endpoint that resolves an arbitrary CVE or
+	// GHSA ID directly (GET /v1/vulns/{id} only accepts OSV's own IDs,
+	// which a bare CVE ID usually isn't). Instead, query by package and
+	// use each result's own aliases to recognize the same vulnerability
+	// under a different ID, rather than surfacing every advisory that
+	// happens to affect the module.
+	aliases := reportAliases(r)
+	for _, m := range r.Modules {
+		entries, err := x.osv.queryPackage(ctx, m.Module)
+		if err != nil {
+			fmt.Fprintf(out, "\nOSV: could not query %v: %v", m.Module, err)
+			continue
+		}
+		for _, e := range entries {
+			if !sharesAlias(e, aliases) {
+				continue
+			}
+			if !x.rc.HasSource(e.ID) {
+				fmt.Fprintf(out, "\n%v (OSV) references module %v, not found in local repo", e.ID, m.Module)
+			}
+		}
+	}
+
+	if *graphQL {
+		advisories, err := queryGHSAGraphQL(ctx, r)
+		if err != nil {
+			fmt.Fprintf(out, "\nGHSA: %v", err)
+		} else {
+			for _, a := range advisories {
+				if !x.rc.HasSource(a.GHSAID) {
+					fmt.Fprintf(out, "\n%v (GHSA) references module %v, not found in local repo", a.GHSAID, a.Module)
+				}
+			}
+		}
+	}
+
+	return out.String(), nil
+}
+
+// reportAliases returns the CVE and GHSA IDs associated with r: its own
+// CVE ID (if the Go CNA issued one) plus any cross-referenced CVE/GHSA
+// IDs recorded on the report.
+func reportAliases(r *report.Report) []string {
+	var ids []string
+	if r.CVEMetadata != nil && r.CVEMetadata.ID != "" {
+		ids = append(ids, r.CVEMetadata.ID)
+	}
+	ids = append(ids, r.CVEs...)
+	ids = append(ids, r.GHSAs...)
+	return ids
+}
+
+// sharesAlias reports whether e is the same vulnerability as one
+// identified by aliases: either its own ID or one of its OSV aliases
+// matches.
+func sharesAlias(e osvEntry, aliases []string) bool {
+	for _, a := range aliases {
+		if e.ID == a {
+			return true
+		}
+		for _, eAlias := range e.Aliases {
+			if eAlias == a {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// osvEntry is the subset of an OSV record that xrefUpstream needs.
+type osvEntry struct {
+	ID      string   `json:"id"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// osvClient queries the OSV database (https://osv.dev), caching
+// responses by query for the lifetime of the process.
+type osvClient struct {
+	mu       sync.Mutex
+	pkgCache map[string][]osvEntry // keyed by module path
+}
+
+func newOSVClient() *osvClient {
+	return &osvClient{pkgCache: make(map[string][]osvEntry)}
+}
+
+// queryPackage returns the OSV entries that reference the Go package
+// ecosystem module modulePath. The result (including a nil result when
+// offline or otherwise unreachable) is cached for the lifetime of the
+// process, so repeated lookups of the same module don't refetch.
+func (c *osvClient) queryPackage(ctx context.Context, modulePath string) ([]osvEntry, error) {
+	c.mu.Lock()
+	if entries, ok := c.pkgCache[modulePath]; ok {
+		c.mu.Unlock()
+		return entries, nil
+	}
+	c.mu.Unlock()
+
+	entries, err := c.fetchPackage(ctx, modulePath)
+
+	c.mu.Lock()
+	c.pkgCache[modulePath] = entries
+	c.mu.Unlock()
+	return entries, err
+}
+
+func (c *osvClient) fetchPackage(ctx context.Context, modulePath string) ([]osvEntry, error) {
+	body, err := json.Marshal(map[string]any{
+		"package": map[string]string{
+			"name":      modulePath,
+			"ecosystem": "Go",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.osv.dev/v1/query", strings.NewReader(string(body)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		// Offline or otherwise unreachable; fall back gracefully.
+		return nil, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OSV query for %v: %v", modulePath, resp.Status)
+	}
+
+	var result struct {
+		Vulns []osvEntry `json:"vulns"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Vulns, nil
+}
+
+// ghsaAdvisory is the subset of a GitHub Security Advisory that
+// xrefUpstream needs.
+type ghsaAdvisory struct {
+	GHSAID string
+	Module string
+}
+
+// queryGHSAGraphQL queries the GitHub GraphQL API for security
+// advisories related to the modules in r, using the token in the
+// GITHUB_TOKEN environment variable for authentication.
+func queryGHSAGraphQL(ctx context.Context, r *report.Report) ([]ghsaAdvisory, error) {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("GITHUB_TOKEN not set, skipping GHSA GraphQL lookup")
+	}
+
+	const query = `query($ecosystem: SecurityAdvisoryEcosystem!, $package: String!) {
+		securityVulnerabilities(ecosystem: $ecosystem, package: $package, first: 10) {
+			nodes { advisory { ghsaId } }
+		}
+	}`
+
+	var advisories []ghsaAdvisory
+	for _, m := range r.Modules {
+		body, err := json.Marshal(map[string]any{
+			"query": query,
+			"variables": map[string]any{
+				"ecosystem": "GO",
+				"package":   m.Module,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.github.com/graphql", strings.NewReader(string(body)))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("could not query GHSA GraphQL API: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("GHSA GraphQL query for %v: %v", m.Module, resp.Status)
+		}
+		func() {
+			defer resp.Body.Close()
+			var result struct {
+				Data struct {
+					SecurityVulnerabilities struct {
+						Nodes []struct {
+							Advisory struct {
+								GHSAID string `json:"ghsaId"`
+							} `json:"advisory"`
+						} `json:"nodes"`
+					} `json:"securityVulnerabilities"`
+				} `json:"data"`
+			}
+			if jerr := json.NewDecoder(resp.Body).Decode(&result); jerr == nil {
+				for _, n := range result.Data.SecurityVulnerabilities.Nodes {
+					advisories = append(advisories, ghsaAdvisory{GHSAID: n.Advisory.GHSAID, Module: m.Module})
+				}
+			}
+		}()
+	}
+	return advisories, nil
+}
+
 func sorted[E constraints.Ordered](s []E) []E {
 	s = slices.Clone(s)
 	slices.Sort(s)
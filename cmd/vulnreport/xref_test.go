@@ -0,0 +1,73 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestReportAliases(t *testing.T) {
+	r := &report.Report{
+		CVEMetadata: &report.CVEMetadata{ID: "CVE-2024-0001"},
+		CVEs:        []string{"CVE-2023-9999"},
+		GHSAs:       []string{"GHSA-aaaa-bbbb-cccc"},
+	}
+	want := []string{"CVE-2024-0001", "CVE-2023-9999", "GHSA-aaaa-bbbb-cccc"}
+	if got := reportAliases(r); !reflect.DeepEqual(got, want) {
+		t.Errorf("reportAliases() = %v, want %v", got, want)
+	}
+}
+
+func TestReportAliasesNoCVEMetadata(t *testing.T) {
+	r := &report.Report{GHSAs: []string{"GHSA-aaaa-bbbb-cccc"}}
+	want := []string{"GHSA-aaaa-bbbb-cccc"}
+	if got := reportAliases(r); !reflect.DeepEqual(got, want) {
+		t.Errorf("reportAliases() = %v, want %v", got, want)
+	}
+}
+
+func TestSharesAlias(t *testing.T) {
+	tests := []struct {
+		name    string
+		e       osvEntry
+		aliases []string
+		want    bool
+	}{
+		{
+			name:    "matches own ID",
+			e:       osvEntry{ID: "GHSA-aaaa-bbbb-cccc"},
+			aliases: []string{"GHSA-aaaa-bbbb-cccc"},
+			want:    true,
+		},
+		{
+			name:    "matches an OSV alias",
+			e:       osvEntry{ID: "GO-2024-0001", Aliases: []string{"CVE-2024-0001"}},
+			aliases: []string{"CVE-2024-0001"},
+			want:    true,
+		},
+		{
+			name:    "no match",
+			e:       osvEntry{ID: "GO-2024-0001", Aliases: []string{"CVE-2024-0001"}},
+			aliases: []string{"CVE-2024-9999"},
+			want:    false,
+		},
+		{
+			name:    "no aliases to match against",
+			e:       osvEntry{ID: "GO-2024-0001"},
+			aliases: nil,
+			want:    false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := sharesAlias(tc.e, tc.aliases); got != tc.want {
+				t.Errorf("sharesAlias(%+v, %v) = %v, want %v", tc.e, tc.aliases, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"golang.org/x/vulndb/internal/cwe"
+	"golang.org/x/vulndb/internal/stdlib"
+)
+
+// maxTitleLength is the maximum length of a CVE 5.0 title, per the
+// CVE JSON 5.0 schema.
+const maxTitleLength = 256
+
+// minDescriptionLength is the minimum length of a CVE 5.0 description,
+// per the CVE JSON 5.0 schema.
+const minDescriptionLength = 10
+
+// allowedCreditRoles is the set of credit "type" values permitted by the
+// CVE JSON 5.0 schema.
+var allowedCreditRoles = map[string]bool{
+	"finder": true, "reporter": true, "analyst": true, "coordinator": true,
+	"remediation developer": true, "remediation reviewer": true,
+	"remediation verifier": true, "tool": true, "sponsor": true,
+	"other": true,
+}
+
+// Lint validates a CVERecord produced by FromReport against the CVE JSON
+// 5.0 schema and the Go CNA's additional requirements. Each check stops
+// at the first problem it finds, so Lint returns at most one error per
+// check. A nil/empty result means the record is safe to submit.
+//
+// Lint is intentionally a collection of small, independently testable
+// checks, following the pattern used by the YAML report linter.
+func Lint(c *CVERecord) []error {
+	var errs []error
+	check := func(fn func(*CVERecord) error) {
+		if err := fn(c); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	check(lintProviderMetadata)
+	check(lintTitle)
+	check(lintDescriptions)
+	check(lintAffected)
+	check(lintCWE)
+	check(lintReferences)
+	check(lintCredits)
+	check(lintPlatforms)
+
+	return errs
+}
+
+func lintProviderMetadata(c *CVERecord) error {
+	if c.Containers.CNAContainer.ProviderMetadata.OrgID == "" {
+		return fmt.Errorf("missing provider org ID")
+	}
+	return nil
+}
+
+func lintTitle(c *CVERecord) error {
+	title := c.Containers.CNAContainer.Title
+	if title == "" {
+		return fmt.Errorf("missing title")
+	}
+	if len(title) > maxTitleLength {
+		return fmt.Errorf("title exceeds %d characters: %q", maxTitleLength, title)
+	}
+	return nil
+}
+
+// htmlTagRE matches an HTML/XML-like tag (e.g. "<a href=...>" or
+// "</script>"), as opposed to a bare "<"/">" used in comparison prose
+// (e.g. "triggered when n < 0").
+var htmlTagRE = regexp.MustCompile(`</?[a-zA-Z][a-zA-Z0-9]*(\s[^<>]*)?>`)
+
+func lintDescriptions(c *CVERecord) error {
+	var en *Description
+	for i, d := range c.Containers.CNAContainer.Descriptions {
+		if d.Lang == "en" {
+			en = &c.Containers.CNAContainer.Descriptions[i]
+			break
+		}
+	}
+	if en == nil {
+		return fmt.Errorf("missing descriptions[lang=en]")
+	}
+	if htmlTagRE.MatchString(en.Value) {
+		return fmt.Errorf("description appears to contain HTML: %q", en.Value)
+	}
+	if len(en.Value) < minDescriptionLength {
+		return fmt.Errorf("description shorter than %d characters: %q", minDescriptionLength, en.Value)
+	}
+	return nil
+}
+
+func lintAffected(c *CVERecord) error {
+	affected := c.Containers.CNAContainer.Affected
+	if len(affected) == 0 {
+		return fmt.Errorf("no affected products listed")
+	}
+	for _, a := range affected {
+		if a.Vendor == "" {
+			return fmt.Errorf("affected entry for %q missing vendor", a.Product)
+		}
+		if a.Product == "" {
+			return fmt.Errorf("affected entry for %q missing product", a.Vendor)
+		}
+	}
+	return nil
+}
+
+// cweIDRE matches a well-formed CWE ID at the start of a problem type
+// description, e.g. "CWE-79: Cross-site Scripting".
+var cweIDRE = regexp.MustCompile(`^CWE-[0-9]+\b`)
+
+func lintCWE(c *CVERecord) error {
+	for _, pt := range c.Containers.CNAContainer.ProblemTypes {
+		for _, d := range pt.Descriptions {
+			if d.CWEID == "" {
+				return fmt.Errorf("problem type description missing cweId: %q", d.Description)
+			}
+			if !cwe.IsValidID(d.CWEID) {
+				return fmt.Errorf("malformed CWE ID: %q", d.CWEID)
+			}
+		}
+	}
+	return nil
+}
+
+func lintReferences(c *CVERecord) error {
+	for _, ref := range c.Containers.CNAContainer.References {
+		if ref.URL == "" {
+			return fmt.Errorf("reference missing URL")
+		}
+		u, err := url.Parse(ref.URL)
+		if err != nil || !u.IsAbs() {
+			return fmt.Errorf("malformed reference URL: %q", ref.URL)
+		}
+	}
+	return nil
+}
+
+func lintCredits(c *CVERecord) error {
+	for _, credit := range c.Containers.CNAContainer.Credits {
+		if credit.Value == "" {
+			return fmt.Errorf("credit missing value")
+		}
+		if credit.Type != "" && !allowedCreditRoles[credit.Type] {
+			return fmt.Errorf("credit %q has unrecognized type %q", credit.Value, credit.Type)
+		}
+	}
+	return nil
+}
+
+func lintPlatforms(c *CVERecord) error {
+	for _, a := range c.Containers.CNAContainer.Affected {
+		for _, p := range a.Platforms {
+			if !stdlib.IsGOOS(p) {
+				return fmt.Errorf("affected entry for %q has unrecognized platform %q", a.Product, p)
+			}
+		}
+	}
+	return nil
+}
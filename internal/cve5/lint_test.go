@@ -0,0 +1,88 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import "testing"
+
+func TestLintCWE(t *testing.T) {
+	tests := []struct {
+		name    string
+		cweID   string
+		wantErr bool
+	}{
+		{"valid", "CWE-400", false},
+		{"valid out-of-bounds read", "CWE-125", false},
+		{"valid out-of-bounds write", "CWE-787", false},
+		{"valid link following", "CWE-59", false},
+		{"missing", "", true},
+		{"malformed", "not-a-cwe-id", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &CVERecord{Containers: Containers{CNAContainer: CNAPublishedContainer{
+				ProblemTypes: []ProblemType{{
+					Descriptions: []ProblemTypeDescription{{
+						Lang:        "en",
+						Description: "Uncontrolled Resource Consumption",
+						CWEID:       tc.cweID,
+					}},
+				}},
+			}}}
+			err := lintCWE(c)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("lintCWE() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestLintCredits(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		typ     string
+		wantErr bool
+	}{
+		{"no type", "Jane Doe", "", false},
+		{"valid type", "Jane Doe", "finder", false},
+		{"another valid type", "Jane Doe", "remediation developer", false},
+		{"missing value", "", "finder", true},
+		{"unrecognized type", "Jane Doe", "whistleblower", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &CVERecord{Containers: Containers{CNAContainer: CNAPublishedContainer{
+				Credits: []Credit{{Lang: "en", Value: tc.value, Type: tc.typ}},
+			}}}
+			err := lintCredits(c)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("lintCredits() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestLintDescriptionsHTMLHeuristic(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"plain prose", "triggered when n < 0 or m > 100, causing a crash", false},
+		{"script tag", "a description with <script>alert(1)</script> embedded", true},
+		{"anchor tag", `see <a href="http://example.com">here</a> for details`, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &CVERecord{Containers: Containers{CNAContainer: CNAPublishedContainer{
+				Descriptions: []Description{{Lang: "en", Value: tc.value}},
+			}}}
+			err := lintDescriptions(c)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("lintDescriptions() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
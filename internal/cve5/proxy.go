@@ -0,0 +1,291 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/vulndb/internal/report"
+)
+
+// proxyTimeout bounds how long a single module proxy request may take,
+// so that a slow or unreachable proxy can't hang the vulnreport CLI
+// indefinitely.
+const proxyTimeout = 15 * time.Second
+
+// proxyClient queries the Go module proxy to validate module paths and
+// versions, caching responses in-process so that repeated lookups (e.g.,
+// across a batch of reports referencing the same module) don't refetch
+// the same data.
+type proxyClient struct {
+	// bases is the ordered list of proxy base URLs to try, as configured
+	// by GOPROXY (comma- or pipe-separated fallback list).
+	bases []string
+
+	// offline, if set, skips all network access. Checks that would
+	// require a network round trip are treated as unverifiable and
+	// silently skipped, rather than reported as failures.
+	offline bool
+
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	versions  map[string][]string // module -> versions from @v/list
+	canonical map[string]string   // module@version -> canonical module path from @v/<version>.mod
+}
+
+// newProxyClient creates a proxyClient using the GOPROXY environment
+// variable, or proxy.golang.org if it is unset.
+func newProxyClient() *proxyClient {
+	goproxy := os.Getenv("GOPROXY")
+	if goproxy == "" {
+		goproxy = "https://proxy.golang.org"
+	}
+	return &proxyClient{
+		bases:      splitGOPROXY(goproxy),
+		httpClient: &http.Client{Timeout: proxyTimeout},
+		versions:   make(map[string][]string),
+		canonical:  make(map[string]string),
+	}
+}
+
+// splitGOPROXY splits a GOPROXY value into its ordered list of fallback
+// URLs, as described in `go help goproxy`: entries are separated by
+// comma (try next on any error) or pipe (try next only on not-found).
+// For our purposes, we only need the ordered list of URLs to try.
+func splitGOPROXY(goproxy string) []string {
+	var bases []string
+	for _, part := range strings.FieldsFunc(goproxy, func(r rune) bool {
+		return r == ',' || r == '|'
+	}) {
+		part = strings.TrimSpace(part)
+		if part != "" && part != "direct" && part != "off" {
+			bases = append(bases, strings.TrimSuffix(part, "/"))
+		}
+	}
+	return bases
+}
+
+// moduleVersions returns the list of known versions for the given
+// module, as reported by the proxy's @v/list endpoint.
+func (c *proxyClient) moduleVersions(module string) ([]string, error) {
+	if c.offline {
+		return nil, nil
+	}
+
+	c.mu.Lock()
+	if vs, ok := c.versions[module]; ok {
+		c.mu.Unlock()
+		return vs, nil
+	}
+	c.mu.Unlock()
+
+	body, err := c.get(module, "@v/list")
+	if err != nil {
+		return nil, err
+	}
+	var vs []string
+	sc := bufio.NewScanner(strings.NewReader(body))
+	for sc.Scan() {
+		if line := strings.TrimSpace(sc.Text()); line != "" {
+			vs = append(vs, line)
+		}
+	}
+
+	c.mu.Lock()
+	c.versions[module] = vs
+	c.mu.Unlock()
+	return vs, nil
+}
+
+// canonicalModule returns the module path declared by the `module`
+// directive of the go.mod for module@version, as reported by the proxy's
+// @v/<version>.mod endpoint.
+func (c *proxyClient) canonicalModule(module, version string) (string, error) {
+	if c.offline {
+		return module, nil
+	}
+
+	key := module + "@" + version
+	c.mu.Lock()
+	if mp, ok := c.canonical[key]; ok {
+		c.mu.Unlock()
+		return mp, nil
+	}
+	c.mu.Unlock()
+
+	body, err := c.get(module, fmt.Sprintf("@v/%s.mod", version))
+	if err != nil {
+		return "", err
+	}
+	mp := module
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if rest, ok := strings.CutPrefix(line, "module "); ok {
+			mp = strings.Trim(strings.TrimSpace(rest), `"`)
+			break
+		}
+	}
+
+	c.mu.Lock()
+	c.canonical[key] = mp
+	c.mu.Unlock()
+	return mp, nil
+}
+
+// get fetches path under the escaped module from each configured proxy
+// base in order, returning the first successful response.
+func (c *proxyClient) get(module, path string) (body string, err error) {
+	escaped, err := escapeModulePath(module)
+	if err != nil {
+		return "", err
+	}
+
+	var errs []error
+	for _, base := range c.bases {
+		u := fmt.Sprintf("%s/%s/%s", base, escaped, path)
+		resp, err := c.httpClient.Get(u)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		b, err := readAndClose(resp)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			errs = append(errs, fmt.Errorf("%s: %s", u, resp.Status))
+			continue
+		}
+		return b, nil
+	}
+	return "", fmt.Errorf("could not fetch %s/%s from any proxy: %w", module, path, joinErrs(errs))
+}
+
+func readAndClose(resp *http.Response) (string, error) {
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	return string(b), err
+}
+
+func joinErrs(errs []error) error {
+	ss := make([]string, len(errs))
+	for i, e := range errs {
+		ss[i] = e.Error()
+	}
+	return fmt.Errorf("%s", strings.Join(ss, "; "))
+}
+
+// escapeModulePath escapes capital letters in a module path as required
+// by the module proxy protocol (e.g. "Foo" -> "!foo"), mirroring
+// golang.org/x/mod/module.EscapePath. Path separators are left alone:
+// the proxy protocol routes on them, and running the whole path through
+// url.PathEscape would turn them into "%2F", which many GOPROXY
+// backends (static mirrors served by nginx, Artifactory, Athens, etc.)
+// don't decode back.
+func escapeModulePath(module string) (string, error) {
+	var b strings.Builder
+	for _, r := range module {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteByte('!')
+			b.WriteRune(r + ('a' - 'A'))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), nil
+}
+
+// LintVersions checks that the module paths and versions referenced by
+// r's affected ranges are canonical and actually exist in the Go module
+// proxy. It returns one error per problem found; a nil/empty result
+// means everything checked out.
+//
+// LintVersions honors the GOPROXY environment variable, trying each
+// configured proxy in order. Pass an offline proxyClient (via
+// WithOfflineProxy) in tests that shouldn't make network calls.
+func LintVersions(r *report.Report, opts ...ProxyOption) []error {
+	pc := newProxyClient()
+	for _, opt := range opts {
+		opt(pc)
+	}
+	if pc.offline {
+		// Nothing is verifiable without the network; don't report
+		// every version as missing.
+		return nil
+	}
+
+	var errs []error
+	for _, m := range r.Modules {
+		known, err := pc.moduleVersions(m.Module)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("module %s: %v", m.Module, err))
+			continue
+		}
+		for _, vr := range m.Versions {
+			for _, v := range []string{vr.Introduced, vr.Fixed} {
+				if v == "" {
+					continue
+				}
+				// Reports store unprefixed versions ("1.2.0"), but the
+				// proxy's @v/list and @v/<version>.mod endpoints key on
+				// v-prefixed semver ("v1.2.0").
+				pv := proxyVersion(v)
+				if !containsVersion(known, pv) {
+					errs = append(errs, fmt.Errorf("module %s: version %s not found on proxy", m.Module, v))
+					continue
+				}
+				canonical, err := pc.canonicalModule(m.Module, pv)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("module %s@%s: %v", m.Module, v, err))
+					continue
+				}
+				if canonical != m.Module {
+					errs = append(errs, fmt.Errorf("module %s@%s: proxy reports canonical module path %q", m.Module, v, canonical))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// proxyVersion converts an unprefixed vulndb report version (e.g.
+// "1.2.0") into the v-prefixed form the module proxy expects (e.g.
+// "v1.2.0"), as internal/version.IsValid does for the same reason.
+func proxyVersion(v string) string {
+	if v == "" || strings.HasPrefix(v, "v") {
+		return v
+	}
+	return "v" + v
+}
+
+func containsVersion(known []string, v string) bool {
+	for _, k := range known {
+		if k == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ProxyOption configures a proxyClient used by LintVersions.
+type ProxyOption func(*proxyClient)
+
+// WithOfflineProxy configures LintVersions to skip network access
+// entirely, for use in tests.
+func WithOfflineProxy() ProxyOption {
+	return func(pc *proxyClient) {
+		pc.offline = true
+		pc.bases = nil
+	}
+}
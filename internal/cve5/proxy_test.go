@@ -0,0 +1,111 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestLintVersionsOffline(t *testing.T) {
+	r := &report.Report{
+		Modules: []*report.Module{
+			{
+				Module: "golang.org/x/vulndb",
+				Versions: []report.VersionRange{
+					{Introduced: "1.0.0", Fixed: "1.0.5"},
+				},
+			},
+		},
+	}
+
+	// Offline mode can't verify anything against the proxy, so it must
+	// not report every version as missing.
+	if errs := LintVersions(r, WithOfflineProxy()); len(errs) != 0 {
+		t.Errorf("LintVersions(r, WithOfflineProxy()) = %v, want no errors", errs)
+	}
+}
+
+func TestProxyVersion(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"1.2.0", "v1.2.0"},
+		{"v1.2.0", "v1.2.0"},
+		{"", ""},
+	}
+	for _, tc := range tests {
+		if got := proxyVersion(tc.in); got != tc.want {
+			t.Errorf("proxyVersion(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestContainsVersionMatchesProxyPrefix(t *testing.T) {
+	known := []string{"v1.0.0", "v1.0.5"}
+	if !containsVersion(known, proxyVersion("1.0.5")) {
+		t.Errorf("containsVersion(%v, proxyVersion(%q)) = false, want true", known, "1.0.5")
+	}
+}
+
+// TestProxyClientPreservesPathSeparators exercises get (via
+// moduleVersions and canonicalModule) against a real HTTP server,
+// checking that a module path with multiple elements and a capital
+// letter is escaped the way the module proxy protocol requires:
+// letter-casing escaped, but "/" left as a literal path separator
+// rather than percent-encoded.
+func TestProxyClientPreservesPathSeparators(t *testing.T) {
+	const module = "example.com/Foo/Bar"
+	const wantEscapedPrefix = "/example.com/!foo/!bar/"
+
+	var gotPaths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.Path)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "@v/list"):
+			fmt.Fprint(w, "v1.0.0\nv1.2.0\n")
+		case strings.HasSuffix(r.URL.Path, "@v/v1.2.0.mod"):
+			fmt.Fprintf(w, "module %s\n", module)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	pc := newProxyClient()
+	pc.bases = []string{srv.URL}
+
+	vs, err := pc.moduleVersions(module)
+	if err != nil {
+		t.Fatalf("moduleVersions(%q) = %v", module, err)
+	}
+	if want := []string{"v1.0.0", "v1.2.0"}; !reflect.DeepEqual(vs, want) {
+		t.Errorf("moduleVersions(%q) = %v, want %v", module, vs, want)
+	}
+
+	canon, err := pc.canonicalModule(module, "v1.2.0")
+	if err != nil {
+		t.Fatalf("canonicalModule(%q, v1.2.0) = %v", module, err)
+	}
+	if canon != module {
+		t.Errorf("canonicalModule(%q, v1.2.0) = %q, want %q", module, canon, module)
+	}
+
+	if len(gotPaths) == 0 {
+		t.Fatal("server received no requests")
+	}
+	for _, p := range gotPaths {
+		if strings.Contains(p, "%2F") {
+			t.Errorf("request path %q percent-encodes a path separator", p)
+		}
+		if !strings.HasPrefix(p, wantEscapedPrefix) {
+			t.Errorf("request path %q does not start with %q", p, wantEscapedPrefix)
+		}
+	}
+}
@@ -20,8 +20,26 @@ var (
 	// The universal unique identifier for the Go Project CNA, which
 	// needs to be included CVE JSON 5.0 records.
 	GoOrgUUID = "1bb62c36-49e3-4200-9d77-64a1400537cc"
+
+	// UseWildcardRanges controls how open-ended affected ranges (no
+	// final fixed version) are encoded in CVE 5.0 output.
+	//
+	// When false (the default, for back-compat with existing records),
+	// an open-ended range is inverted into a series of "unaffected"
+	// ranges, since older consumers assumed the schema had no way to
+	// express an open upper bound.
+	//
+	// When true, an open-ended range is instead encoded directly as a
+	// single affected range with "lessThan: *", which the CVE 5.0
+	// schema now supports and which preserves the original intent
+	// ("version X and above are affected") without inversion.
+	UseWildcardRanges = false
 )
 
+// wildcardVersion is the CVE 5.0 schema's wildcard value, used as the
+// lessThan bound of an affected range with no known upper bound.
+const wildcardVersion = "*"
+
 // FromReport creates a CVE in 5.0 format from a YAML report file.
 func FromReport(r *report.Report) (_ *CVERecord, err error) {
 	defer derrors.Wrap(&err, "FromReport(%q)", r.ID)
@@ -57,6 +75,7 @@ func FromReport(r *report.Report) (_ *CVERecord, err error) {
 					{
 						Lang:        "en",
 						Description: r.CVEMetadata.CWE,
+						CWEID:       cweIDRE.FindString(r.CVEMetadata.CWE),
 					},
 				},
 			},
@@ -99,6 +118,19 @@ func FromReport(r *report.Report) (_ *CVERecord, err error) {
 		})
 	}
 
+	if r.CVEMetadata.CVSSVector != "" {
+		c.Metrics = []Metrics{
+			{
+				CVSSv3_1: &CVSSv3_1{
+					Version:      "3.1",
+					VectorString: r.CVEMetadata.CVSSVector,
+					BaseScore:    r.CVEMetadata.CVSSBaseScore,
+					BaseSeverity: r.CVEMetadata.CVSSBaseSeverity,
+				},
+			},
+		}
+	}
+
 	return &CVERecord{
 		DataType:    "CVE_RECORD",
 		DataVersion: "5.0",
@@ -123,12 +155,38 @@ func versionRangeToVersionRange(versions []report.VersionRange) ([]VersionRange,
 	}
 
 	var cveVRs []VersionRange
+	openEnded := versions[len(versions)-1].Fixed == ""
+
+	if openEnded && UseWildcardRanges {
+		// Express the open-ended range directly as a single affected
+		// range with no upper bound, using the schema's wildcard
+		// lessThan value. This preserves "version X and above are
+		// affected" without inverting it into unaffected ranges.
+		for _, vr := range versions {
+			cveVR := VersionRange{
+				Status:      StatusAffected,
+				VersionType: typeSemver,
+				Fixed:       wildcardVersion,
+			}
+			if vr.Introduced != "" {
+				cveVR.Introduced = Version(vr.Introduced)
+			} else {
+				cveVR.Introduced = versionZero
+			}
+			if vr.Fixed != "" {
+				cveVR.Fixed = Version(vr.Fixed)
+			}
+			cveVRs = append(cveVRs, cveVR)
+		}
+		return cveVRs, StatusUnaffected
+	}
 
 	// If there is no final fixed version, then the default status is
 	// "affected" and we express the versions in terms of which ranges
 	// are *unaffected*. This is due to the fact that the CVE schema
-	// does not allow us to express a range as "version X.X.X and above are affected".
-	if versions[len(versions)-1].Fixed == "" {
+	// historically had no way to express a range as "version X.X.X and
+	// above are affected".
+	if openEnded {
 		current := &VersionRange{}
 		for _, vr := range versions {
 			if vr.Introduced != "" {
@@ -208,9 +266,24 @@ func cve5ToReport(c *CVERecord, modulePath string) *report.Report {
 	}
 
 	r.AddCVE(c.Metadata.ID, getCWE5(&cna), isGoCNA5(&cna))
+	if cvss := getCVSS3_1(&cna); cvss != nil {
+		r.CVEMetadata.CVSSVector = cvss.VectorString
+		r.CVEMetadata.CVSSBaseScore = cvss.BaseScore
+		r.CVEMetadata.CVSSBaseSeverity = cvss.BaseSeverity
+	}
 	return r
 }
 
+// getCVSS3_1 returns the CVSS v3.1 metric on c, if present.
+func getCVSS3_1(c *CNAPublishedContainer) *CVSSv3_1 {
+	for _, m := range c.Metrics {
+		if m.CVSSv3_1 != nil {
+			return m.CVSSv3_1
+		}
+	}
+	return nil
+}
+
 func getCWE5(c *CNAPublishedContainer) string {
 	if len(c.ProblemTypes) == 0 || len(c.ProblemTypes[0].Descriptions) == 0 {
 		return ""
@@ -333,6 +406,24 @@ func toVersionRange(cvr *VersionRange, defaultStatus VersionStatus) (*report.Ver
 		}, true
 	}
 
+	// Case three: an open-ended range with no upper bound, encoded with
+	// the schema's wildcard lessThan value (see UseWildcardRanges).
+	if cvr.Fixed == wildcardVersion {
+		if cvr.VersionType != typeSemver ||
+			cvr.LessThanOrEqual != "" ||
+			!version.IsValid(string(cvr.Introduced)) ||
+			cvr.Status != StatusAffected {
+			return nil, false
+		}
+		introduced := string(cvr.Introduced)
+		if introduced == "0" {
+			introduced = ""
+		}
+		return &report.VersionRange{
+			Introduced: introduced,
+		}, true
+	}
+
 	// For now, don't attempt to fix any other messed up cases.
 	if cvr.VersionType != typeSemver ||
 		cvr.LessThanOrEqual != "" ||
@@ -0,0 +1,85 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/vulndb/internal/report"
+)
+
+func TestCVSSRoundTripThroughCVERecord(t *testing.T) {
+	r := &report.Report{
+		Summary: "example vulnerability",
+		Modules: []*report.Module{
+			{Module: "golang.org/x/vulndb"},
+		},
+		CVEMetadata: &report.CVEMetadata{
+			ID:               "CVE-2024-0001",
+			CWE:              "CWE-400: Uncontrolled Resource Consumption",
+			Description:      "An attacker can cause excessive resource consumption.",
+			CVSSVector:       "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H",
+			CVSSBaseScore:    7.5,
+			CVSSBaseSeverity: "HIGH",
+		},
+	}
+
+	rec, err := FromReport(r)
+	if err != nil {
+		t.Fatalf("FromReport() = %v", err)
+	}
+
+	got := cve5ToReport(rec, "golang.org/x/vulndb")
+	if got.CVEMetadata == nil {
+		t.Fatalf("cve5ToReport() CVEMetadata = nil")
+	}
+	if got.CVEMetadata.CVSSVector != r.CVEMetadata.CVSSVector {
+		t.Errorf("CVSSVector = %q, want %q", got.CVEMetadata.CVSSVector, r.CVEMetadata.CVSSVector)
+	}
+	if got.CVEMetadata.CVSSBaseScore != r.CVEMetadata.CVSSBaseScore {
+		t.Errorf("CVSSBaseScore = %v, want %v", got.CVEMetadata.CVSSBaseScore, r.CVEMetadata.CVSSBaseScore)
+	}
+	if got.CVEMetadata.CVSSBaseSeverity != r.CVEMetadata.CVSSBaseSeverity {
+		t.Errorf("CVSSBaseSeverity = %q, want %q", got.CVEMetadata.CVSSBaseSeverity, r.CVEMetadata.CVSSBaseSeverity)
+	}
+}
+
+func TestVersionRangeRoundTripWildcard(t *testing.T) {
+	defer func(orig bool) { UseWildcardRanges = orig }(UseWildcardRanges)
+	UseWildcardRanges = true
+
+	tests := []struct {
+		name string
+		in   []report.VersionRange
+	}{
+		{
+			name: "open-ended",
+			in: []report.VersionRange{
+				{Introduced: "1.2.0"},
+			},
+		},
+		{
+			name: "fixed sub-range followed by open tail",
+			in: []report.VersionRange{
+				{Introduced: "1.0.0", Fixed: "1.1.0"},
+				{Introduced: "1.2.0"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cveVRs, status := versionRangeToVersionRange(tc.in)
+			got, uvs := convertVersions(cveVRs, status)
+			if len(uvs) != 0 {
+				t.Fatalf("got unsupported versions %v, want none", uvs)
+			}
+			if !reflect.DeepEqual(got, tc.in) {
+				t.Errorf("round trip = %+v, want %+v", got, tc.in)
+			}
+		})
+	}
+}
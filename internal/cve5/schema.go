@@ -0,0 +1,132 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cve5
+
+// The types in this file are a partial implementation of the CVE JSON 5.0
+// record format, as documented at
+// https://github.com/CVEProject/cve-schema/blob/master/schema/v5.0/CVE_JSON_5.0_schema.json.
+// Only the fields used by this package are represented.
+
+// CVERecord represents a CVE JSON 5.0 record.
+type CVERecord struct {
+	DataType    string     `json:"dataType"`
+	DataVersion string     `json:"dataVersion"`
+	Metadata    Metadata   `json:"cveMetadata"`
+	Containers  Containers `json:"containers"`
+}
+
+// Metadata is the top-level "cveMetadata" object.
+type Metadata struct {
+	ID string `json:"cveId"`
+}
+
+// Containers holds the CNA container for the record.
+type Containers struct {
+	CNAContainer CNAPublishedContainer `json:"cna"`
+}
+
+// CNAPublishedContainer is the "cna" container of a published CVE record.
+type CNAPublishedContainer struct {
+	ProviderMetadata ProviderMetadata `json:"providerMetadata"`
+	Title            string           `json:"title,omitempty"`
+	Descriptions     []Description    `json:"descriptions"`
+	Affected         []Affected       `json:"affected,omitempty"`
+	ProblemTypes     []ProblemType    `json:"problemTypes,omitempty"`
+	References       []Reference      `json:"references,omitempty"`
+	Credits          []Credit         `json:"credits,omitempty"`
+	Metrics          []Metrics        `json:"metrics,omitempty"`
+}
+
+// ProviderMetadata identifies the CNA that authored the record.
+type ProviderMetadata struct {
+	OrgID string `json:"orgId"`
+}
+
+// Description is a free-text description of the vulnerability in a
+// particular language.
+type Description struct {
+	Lang  string `json:"lang"`
+	Value string `json:"value"`
+}
+
+// ProblemType holds a set of problem type (e.g., CWE) descriptions.
+type ProblemType struct {
+	Descriptions []ProblemTypeDescription `json:"descriptions"`
+}
+
+// ProblemTypeDescription is a single problem type description, typically
+// a CWE ID or name.
+type ProblemTypeDescription struct {
+	Lang        string `json:"lang"`
+	Description string `json:"description"`
+	CWEID       string `json:"cweId,omitempty"`
+}
+
+// Reference is a URL pointing to more information about the vulnerability.
+type Reference struct {
+	URL string `json:"url"`
+}
+
+// Credit acknowledges a contributor to the discovery or remediation of the
+// vulnerability.
+type Credit struct {
+	Lang  string `json:"lang"`
+	Value string `json:"value"`
+	Type  string `json:"type,omitempty"`
+}
+
+// Affected describes a single affected product (for Go, a module or
+// package).
+type Affected struct {
+	Vendor          string           `json:"vendor"`
+	Product         string           `json:"product"`
+	CollectionURL   string           `json:"collectionURL,omitempty"`
+	PackageName     string           `json:"packageName,omitempty"`
+	Platforms       []string         `json:"platforms,omitempty"`
+	Versions        []VersionRange   `json:"versions"`
+	DefaultStatus   VersionStatus    `json:"defaultStatus,omitempty"`
+	ProgramRoutines []ProgramRoutine `json:"programRoutines,omitempty"`
+}
+
+// ProgramRoutine identifies an affected function or method.
+type ProgramRoutine struct {
+	Name string `json:"name"`
+}
+
+// Version is a version string as it appears in a VersionRange.
+type Version string
+
+// VersionStatus is the affectedness status of a VersionRange or a
+// defaultStatus.
+type VersionStatus string
+
+const (
+	StatusAffected   VersionStatus = "affected"
+	StatusUnaffected VersionStatus = "unaffected"
+)
+
+// VersionRange represents a single entry in an Affected.Versions array.
+type VersionRange struct {
+	Introduced      Version       `json:"version"`
+	Fixed           Version       `json:"lessThan,omitempty"`
+	LessThanOrEqual Version       `json:"lessThanOrEqual,omitempty"`
+	Status          VersionStatus `json:"status"`
+	VersionType     string        `json:"versionType,omitempty"`
+}
+
+// Metrics holds the scoring metrics for a CVE record. Exactly one of the
+// fields should be set, per the CVE 5.0 schema's oneOf constraint.
+type Metrics struct {
+	CVSSv3_1 *CVSSv3_1 `json:"cvssV3_1,omitempty"`
+}
+
+// CVSSv3_1 is a CVSS v3.1 score, as described in
+// https://www.first.org/cvss/v3.1/specification-document.
+type CVSSv3_1 struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString"`
+	BaseScore    float64 `json:"baseScore"`
+	BaseSeverity string  `json:"baseSeverity"`
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cwe provides validation of Common Weakness Enumeration (CWE)
+// IDs, as published by MITRE (https://cwe.mitre.org/data/downloads.html).
+package cwe
+
+import "regexp"
+
+// idRE matches a well-formed CWE ID, e.g. "CWE-79".
+var idRE = regexp.MustCompile(`^CWE-[0-9]+$`)
+
+// IsValidID reports whether id is a well-formed CWE ID ("CWE-<n>").
+//
+// This only checks the format of id, not whether MITRE has actually
+// published a weakness with that number: the full catalog has nearly
+// 1000 entries and changes over time, so vendoring or fetching it here
+// would either go stale or make every lint a network call, and either
+// way would risk rejecting a legitimately-numbered CWE that just isn't
+// in our copy of the list. Format validation still catches the common
+// mistakes (a CWE name instead of an ID, a typo'd prefix, a missing ID
+// entirely).
+func IsValidID(id string) bool {
+	return idRE.MatchString(id)
+}
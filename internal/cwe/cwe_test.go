@@ -0,0 +1,29 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cwe
+
+import "testing"
+
+func TestIsValidID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"CWE-79", true},
+		{"CWE-125", true},
+		{"CWE-787", true},
+		{"CWE-59", true},
+		{"", false},
+		{"79", false},
+		{"CWE-", false},
+		{"Out-of-bounds Read", false},
+		{"CWE-999999", true}, // well-formed; IsValidID doesn't check against the MITRE catalog
+	}
+	for _, tc := range tests {
+		if got := IsValidID(tc.id); got != tc.want {
+			t.Errorf("IsValidID(%q) = %v, want %v", tc.id, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package report
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestCVEMetadataCVSSRoundTrip(t *testing.T) {
+	want := &CVEMetadata{
+		ID:               "CVE-2024-0001",
+		CWE:              "CWE-400: Uncontrolled Resource Consumption",
+		CVSSVector:       "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H",
+		CVSSBaseScore:    7.5,
+		CVSSBaseSeverity: "HIGH",
+	}
+
+	b, err := yaml.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal() = %v", err)
+	}
+
+	got := &CVEMetadata{}
+	if err := yaml.Unmarshal(b, got); err != nil {
+		t.Fatalf("Unmarshal() = %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip = %+v, want %+v", got, want)
+	}
+}